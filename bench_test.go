@@ -0,0 +1,53 @@
+package semver_test
+
+import (
+	"testing"
+
+	"github.com/Nidal-Bakir/go-semver"
+)
+
+func BenchmarkParse(b *testing.B) {
+	const input = "1.2.3-alpha.beta.11+build.sha.5114f85"
+	b.ReportAllocs()
+	for i := 0; i < b.N; i++ {
+		_, _ = semver.Parse(input)
+	}
+}
+
+func BenchmarkCompare(b *testing.B) {
+	v1 := semver.MustParse("1.2.3-alpha.beta.11")
+	v2 := semver.MustParse("1.2.3-alpha.beta.2")
+	b.ReportAllocs()
+	for i := 0; i < b.N; i++ {
+		_ = v1.Compare(v2)
+	}
+}
+
+func BenchmarkSort(b *testing.B) {
+	input := []string{
+		"1.0.0-0.3.7",
+		"1.0.0-alpha",
+		"1.0.0-alpha.1",
+		"1.0.0-alpha.beta",
+		"1.0.0-beta",
+		"1.0.0-beta.2",
+		"1.0.0-beta.11",
+		"1.0.0-rc.1",
+		"1.0.0-x.7.z.92",
+		"1.0.0",
+		"2.0.0",
+		"11.11.11",
+		"62.99.57962",
+	}
+
+	versions := make([]semver.SemVer, len(input))
+	for i, s := range input {
+		versions[i] = semver.MustParse(s)
+	}
+
+	b.ReportAllocs()
+	for i := 0; i < b.N; i++ {
+		cloned := append([]semver.SemVer(nil), versions...)
+		semver.Sort(cloned)
+	}
+}
@@ -0,0 +1,49 @@
+package semver
+
+// IncMajor returns a new SemVer with Major incremented, Minor and Patch
+// reset to 0, and PreRelease/BuildMetadata cleared.
+func (s SemVer) IncMajor() SemVer {
+	return SemVer{Major: s.Major + 1}
+}
+
+// IncMinor returns a new SemVer with Minor incremented, Patch reset to 0,
+// and PreRelease/BuildMetadata cleared.
+func (s SemVer) IncMinor() SemVer {
+	return SemVer{Major: s.Major, Minor: s.Minor + 1}
+}
+
+// IncPatch returns a new SemVer with Patch incremented and
+// PreRelease/BuildMetadata cleared.
+func (s SemVer) IncPatch() SemVer {
+	return SemVer{Major: s.Major, Minor: s.Minor, Patch: s.Patch + 1}
+}
+
+// FinalizeRelease strips PreRelease and BuildMetadata, producing the GA
+// version for the same major.minor.patch.
+func (s SemVer) FinalizeRelease() SemVer {
+	return SemVer{Major: s.Major, Minor: s.Minor, Patch: s.Patch}
+}
+
+// WithPreRelease returns a copy of s with PreRelease set to preRelease,
+// after validating preRelease against the SemVer pre-release grammar
+// (non-empty dot-separated identifiers from [0-9A-Za-z-], no leading zero
+// on purely-numeric identifiers).
+func (s SemVer) WithPreRelease(preRelease string) (SemVer, error) {
+	if preRelease != "" && !isStrictDotSeparatedIdentifiers(preRelease, true) {
+		return SemVer{}, ErrInvalidSemVerSyntax
+	}
+	s.PreRelease = preRelease
+	return s, nil
+}
+
+// WithBuildMetadata returns a copy of s with BuildMetadata set to
+// buildMetadata, after validating buildMetadata against the SemVer build
+// metadata grammar (non-empty dot-separated identifiers from
+// [0-9A-Za-z-]).
+func (s SemVer) WithBuildMetadata(buildMetadata string) (SemVer, error) {
+	if buildMetadata != "" && !isStrictDotSeparatedIdentifiers(buildMetadata, false) {
+		return SemVer{}, ErrInvalidSemVerSyntax
+	}
+	s.BuildMetadata = buildMetadata
+	return s, nil
+}
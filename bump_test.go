@@ -0,0 +1,49 @@
+package semver_test
+
+import (
+	"testing"
+
+	"github.com/Nidal-Bakir/go-semver"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestIncMajorMinorPatch(t *testing.T) {
+	a := assert.New(t)
+
+	v := semver.MustParse("1.2.3-alpha+build")
+
+	a.Equal("2.0.0", v.IncMajor().String())
+	a.Equal("1.3.0", v.IncMinor().String())
+	a.Equal("1.2.4", v.IncPatch().String())
+}
+
+func TestFinalizeRelease(t *testing.T) {
+	a := assert.New(t)
+
+	v := semver.MustParse("1.2.3-rc.1+build.5")
+	a.Equal("1.2.3", v.FinalizeRelease().String())
+}
+
+func TestWithPreReleaseAndBuildMetadata(t *testing.T) {
+	a := assert.New(t)
+
+	v := semver.MustParse("1.2.3")
+
+	withPre, err := v.WithPreRelease("rc.1")
+	a.NoError(err)
+	a.Equal("1.2.3-rc.1", withPre.String())
+
+	_, err = v.WithPreRelease("01")
+	a.Error(err)
+
+	withBuild, err := v.WithBuildMetadata("001")
+	a.NoError(err)
+	a.Equal("1.2.3+001", withBuild.String())
+
+	_, err = v.WithBuildMetadata("not valid")
+	a.Error(err)
+
+	cleared, err := v.WithPreRelease("")
+	a.NoError(err)
+	a.Equal("1.2.3", cleared.String())
+}
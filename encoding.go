@@ -0,0 +1,69 @@
+package semver
+
+import (
+	"database/sql/driver"
+	"fmt"
+)
+
+// MarshalJSON implements [encoding/json.Marshaler], encoding the SemVer as
+// its string form (e.g. "1.2.3-rc.1+build.5").
+func (s SemVer) MarshalJSON() ([]byte, error) {
+	text, err := s.MarshalText()
+	if err != nil {
+		return nil, err
+	}
+
+	quoted := make([]byte, 0, len(text)+2)
+	quoted = append(quoted, '"')
+	quoted = append(quoted, text...)
+	quoted = append(quoted, '"')
+	return quoted, nil
+}
+
+// UnmarshalJSON implements [encoding/json.Unmarshaler], expecting the SemVer
+// encoded as a JSON string.
+func (s *SemVer) UnmarshalJSON(data []byte) error {
+	if len(data) < 2 || data[0] != '"' || data[len(data)-1] != '"' {
+		return fmt.Errorf("semver: UnmarshalJSON: not a JSON string: %s", data)
+	}
+	return s.UnmarshalText(data[1 : len(data)-1])
+}
+
+// MarshalText implements [encoding.TextMarshaler], so SemVer works with
+// encoding/xml, YAML libraries, and as a map key in encoding/json.
+func (s SemVer) MarshalText() ([]byte, error) {
+	return []byte(s.String()), nil
+}
+
+// UnmarshalText implements [encoding.TextUnmarshaler].
+func (s *SemVer) UnmarshalText(text []byte) error {
+	v, err := Parse(string(text))
+	if err != nil {
+		return err
+	}
+	*s = v
+	return nil
+}
+
+// Scan implements [database/sql.Scanner], so SemVer can be scanned directly
+// from a string column. It accepts string, []byte, and nil (nil leaves the
+// receiver as the zero SemVer).
+func (s *SemVer) Scan(src any) error {
+	switch v := src.(type) {
+	case nil:
+		*s = SemVer{}
+		return nil
+	case string:
+		return s.UnmarshalText([]byte(v))
+	case []byte:
+		return s.UnmarshalText(v)
+	default:
+		return fmt.Errorf("semver: Scan: unsupported type %T", src)
+	}
+}
+
+// Value implements [database/sql/driver.Valuer], storing the SemVer as its
+// string form.
+func (s SemVer) Value() (driver.Value, error) {
+	return s.String(), nil
+}
@@ -0,0 +1,70 @@
+package semver_test
+
+import (
+	"database/sql/driver"
+	"encoding/json"
+	"testing"
+
+	"github.com/Nidal-Bakir/go-semver"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestJSONRoundTrip(t *testing.T) {
+	a := assert.New(t)
+
+	type wrapper struct {
+		Version semver.SemVer `json:"version"`
+	}
+
+	w := wrapper{Version: semver.MustParse("1.2.3-rc.1+build.5")}
+
+	data, err := json.Marshal(w)
+	a.NoError(err)
+	a.Equal(`{"version":"1.2.3-rc.1+build.5"}`, string(data))
+
+	var got wrapper
+	a.NoError(json.Unmarshal(data, &got))
+	a.True(w.Version.IsEquql(got.Version))
+	a.Equal(w.Version.BuildMetadata, got.Version.BuildMetadata)
+}
+
+func TestUnmarshalJSONInvalid(t *testing.T) {
+	a := assert.New(t)
+
+	var v semver.SemVer
+	a.Error(v.UnmarshalJSON([]byte("1.2.3")))
+	a.Error(v.UnmarshalJSON([]byte(`"not-a-semver"`)))
+}
+
+func TestMarshalUnmarshalText(t *testing.T) {
+	a := assert.New(t)
+
+	v := semver.MustParse("2.0.0+build")
+	text, err := v.MarshalText()
+	a.NoError(err)
+	a.Equal("2.0.0+build", string(text))
+
+	var got semver.SemVer
+	a.NoError(got.UnmarshalText(text))
+	a.True(v.IsEquql(got))
+}
+
+func TestSQLScanAndValue(t *testing.T) {
+	a := assert.New(t)
+
+	var v semver.SemVer
+	a.NoError(v.Scan("1.2.3"))
+	a.Equal(semver.MustParse("1.2.3").String(), v.String())
+
+	a.NoError(v.Scan([]byte("4.5.6")))
+	a.Equal(semver.MustParse("4.5.6").String(), v.String())
+
+	a.NoError(v.Scan(nil))
+	a.Equal(semver.SemVer{}, v)
+
+	a.Error(v.Scan(42))
+
+	val, err := semver.MustParse("1.0.0-beta").Value()
+	a.NoError(err)
+	a.Equal(driver.Value("1.0.0-beta"), val)
+}
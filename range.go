@@ -0,0 +1,512 @@
+package semver
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+// comparatorOp is a single comparison operator usable inside a [Range].
+type comparatorOp int
+
+const (
+	opEq comparatorOp = iota
+	opNeq
+	opGt
+	opGte
+	opLt
+	opLte
+)
+
+// comparator is a single "<op> <version>" term, e.g. ">= 1.2.3".
+type comparator struct {
+	op  comparatorOp
+	ver SemVer
+}
+
+func (c comparator) matches(v SemVer) bool {
+	cmp := v.Compare(c.ver)
+	switch c.op {
+	case opEq:
+		return cmp == 0
+	case opNeq:
+		return cmp != 0
+	case opGt:
+		return cmp > 0
+	case opGte:
+		return cmp >= 0
+	case opLt:
+		return cmp < 0
+	case opLte:
+		return cmp <= 0
+	default:
+		return false
+	}
+}
+
+func (c comparator) String() string {
+	var op string
+	switch c.op {
+	case opEq:
+		op = "="
+	case opNeq:
+		op = "!="
+	case opGt:
+		op = ">"
+	case opGte:
+		op = ">="
+	case opLt:
+		op = "<"
+	case opLte:
+		op = "<="
+	}
+	return op + c.ver.String()
+}
+
+// Range is a version constraint expression, e.g. ">=1.2.3 <2.0.0 || 3.x".
+//
+// A Range is internally represented as a disjunction ("||") of AND-lists of
+// [comparator] terms, so matching a version against it is just a linear scan
+// reusing [SemVer.Compare].
+type Range struct {
+	// orSets is a disjunction of AND-lists: the range matches a version if
+	// at least one of the inner slices matches it entirely.
+	orSets [][]comparator
+	expr   string
+}
+
+// String returns the original expression the Range was parsed from.
+func (r Range) String() string {
+	return r.expr
+}
+
+// Contains reports whether v satisfies the range.
+//
+// Pre-release versions only satisfy a range if one of the comparators that
+// make up the matching AND-list also carries a pre-release with the same
+// major.minor.patch as v. This mirrors the behavior of ecosystems such as
+// npm and Cargo: a pre-release is only considered "in range" when the range
+// explicitly opts into that exact major.minor.patch pre-release line.
+func (r Range) Contains(v SemVer) bool {
+	for _, andSet := range r.orSets {
+		if andSetMatches(andSet, v) {
+			return true
+		}
+	}
+	return false
+}
+
+func andSetMatches(andSet []comparator, v SemVer) bool {
+	if v.IsPreRelease() && !andSetAllowsPreRelease(andSet, v) {
+		return false
+	}
+	for _, c := range andSet {
+		if !c.matches(v) {
+			return false
+		}
+	}
+	return true
+}
+
+// andSetAllowsPreRelease reports whether any comparator in andSet shares a
+// pre-release and the same major.minor.patch as v.
+func andSetAllowsPreRelease(andSet []comparator, v SemVer) bool {
+	for _, c := range andSet {
+		if c.ver.IsPreRelease() &&
+			c.ver.Major == v.Major && c.ver.Minor == v.Minor && c.ver.Patch == v.Patch {
+			return true
+		}
+	}
+	return false
+}
+
+// MustParseRange is like [ParseRange] but panics if expr is invalid.
+func MustParseRange(expr string) Range {
+	r, err := ParseRange(expr)
+	if err != nil {
+		panic(err)
+	}
+	return r
+}
+
+// ParseRange parses a version range/constraint expression.
+//
+// The grammar supports:
+//
+//   - comparison operators: =, !=, >, >=, <, <=
+//   - conjunction (AND) via whitespace or a comma: ">=1.2.3 <2.0.0", ">=1.2.3,<2.0.0"
+//   - disjunction (OR) via "||": "1.x || 2.x"
+//   - hyphen ranges: "1.2.3 - 2.3.4" (inclusive on both ends)
+//   - wildcard ranges: "1.2.x", "1.x", "*"
+//   - tilde ranges: "~1.2.3" -> ">=1.2.3 <1.3.0"
+//   - caret ranges: "^1.2.3" -> ">=1.2.3 <2.0.0", with the usual zero-major/
+//     zero-minor edge cases: "^0.2.3" -> ">=0.2.3 <0.3.0", "^0.0.3" -> ">=0.0.3 <0.0.4"
+func ParseRange(expr string) (Range, error) {
+	orParts := strings.Split(expr, "||")
+	orSets := make([][]comparator, 0, len(orParts))
+
+	for _, orPart := range orParts {
+		orPart = strings.TrimSpace(orPart)
+		if orPart == "" {
+			return Range{}, fmt.Errorf("semver: invalid range %q: empty clause", expr)
+		}
+
+		andSet, err := parseAndSet(orPart)
+		if err != nil {
+			return Range{}, fmt.Errorf("semver: invalid range %q: %w", expr, err)
+		}
+		orSets = append(orSets, andSet)
+	}
+
+	return Range{orSets: orSets, expr: expr}, nil
+}
+
+func parseAndSet(clause string) ([]comparator, error) {
+	if hyphen, ok := splitHyphenRange(clause); ok {
+		return hyphenRangeComparators(hyphen[0], hyphen[1])
+	}
+
+	terms := splitAndTerms(clause)
+	andSet := make([]comparator, 0, len(terms))
+	for _, term := range terms {
+		cs, err := parseTerm(term)
+		if err != nil {
+			return nil, err
+		}
+		andSet = append(andSet, cs...)
+	}
+	if len(andSet) == 0 {
+		return nil, fmt.Errorf("empty clause")
+	}
+	return andSet, nil
+}
+
+// splitHyphenRange splits "A - B" into [A, B] if clause is a hyphen range.
+// A bare "-" used as a negative-number marker never appears in valid SemVer
+// input, so any " - " surrounded by spaces is unambiguously a hyphen range.
+func splitHyphenRange(clause string) ([2]string, bool) {
+	if idx := strings.Index(clause, " - "); idx != -1 {
+		return [2]string{
+			strings.TrimSpace(clause[:idx]),
+			strings.TrimSpace(clause[idx+len(" - "):]),
+		}, true
+	}
+	return [2]string{}, false
+}
+
+// splitAndTerms splits a clause on commas and/or whitespace into individual
+// comparator terms, e.g. ">=1.2.3 <2.0.0" or ">=1.2.3,<2.0.0".
+func splitAndTerms(clause string) []string {
+	clause = strings.ReplaceAll(clause, ",", " ")
+	fields := strings.Fields(clause)
+	terms := make([]string, 0, len(fields))
+
+	// A term's operator may be separated from its version by whitespace
+	// (">= 1.2.3"), so merge a bare operator field into the following one.
+	for i := 0; i < len(fields); i++ {
+		f := fields[i]
+		if isBareOperator(f) && i+1 < len(fields) {
+			terms = append(terms, f+fields[i+1])
+			i++
+			continue
+		}
+		terms = append(terms, f)
+	}
+	return terms
+}
+
+func isBareOperator(s string) bool {
+	switch s {
+	case "=", "!=", ">", ">=", "<", "<=":
+		return true
+	default:
+		return false
+	}
+}
+
+// parseTerm parses a single comparator term, which may itself be a wildcard,
+// tilde, or caret range that expands to one or two comparators.
+func parseTerm(term string) ([]comparator, error) {
+	switch {
+	case term == "*" || term == "x" || term == "X":
+		return []comparator{{op: opGte, ver: SemVer{}}}, nil
+	case strings.HasPrefix(term, "~"):
+		return tildeRangeComparators(term[1:])
+	case strings.HasPrefix(term, "^"):
+		return caretRangeComparators(term[1:])
+	}
+
+	op, rest := splitOperator(term)
+	if isWildcardPartial(rest) {
+		return wildcardRangeComparators(op, rest)
+	}
+
+	v, err := Parse(rest)
+	if err != nil {
+		return nil, fmt.Errorf("invalid comparator %q: %w", term, err)
+	}
+	return []comparator{{op: op, ver: v}}, nil
+}
+
+func splitOperator(term string) (comparatorOp, string) {
+	switch {
+	case strings.HasPrefix(term, ">="):
+		return opGte, term[2:]
+	case strings.HasPrefix(term, "<="):
+		return opLte, term[2:]
+	case strings.HasPrefix(term, "!="):
+		return opNeq, term[2:]
+	case strings.HasPrefix(term, ">"):
+		return opGt, term[1:]
+	case strings.HasPrefix(term, "<"):
+		return opLt, term[1:]
+	case strings.HasPrefix(term, "="):
+		return opEq, term[1:]
+	default:
+		return opEq, term
+	}
+}
+
+func isWildcardPartial(v string) bool {
+	if v == "" {
+		return false
+	}
+	parts := strings.Split(v, ".")
+	if len(parts) > 3 {
+		return false
+	}
+	for _, p := range parts {
+		if p == "x" || p == "X" || p == "*" {
+			return true
+		}
+	}
+	return len(parts) < 3
+}
+
+// wildcardRangeComparators expands a partial/wildcard version such as
+// "1.2.x", "1.x", or "1" into the equivalent comparator range. An explicit
+// operator other than "=" or ">="/"<=" on a partial version is rejected,
+// matching how the grammar is normally used in the wild.
+func wildcardRangeComparators(op comparatorOp, v string) ([]comparator, error) {
+	major, minor, patch, wild, err := parsePartialVersion(v)
+	if err != nil {
+		return nil, err
+	}
+
+	switch op {
+	case opGt, opLt, opNeq:
+		return nil, fmt.Errorf("operator not allowed on partial version %q", v)
+	}
+
+	switch wild {
+	case wildAll:
+		return []comparator{{op: opGte, ver: SemVer{Major: 0, Minor: 0, Patch: 0}}}, nil
+	case wildMinor:
+		lower := SemVer{Major: major}
+		upper := SemVer{Major: major + 1}
+		return boundedRange(op, lower, upper)
+	case wildPatch:
+		lower := SemVer{Major: major, Minor: minor}
+		upper := SemVer{Major: major, Minor: minor + 1}
+		return boundedRange(op, lower, upper)
+	default:
+		v := SemVer{Major: major, Minor: minor, Patch: patch}
+		return []comparator{{op: op, ver: v}}, nil
+	}
+}
+
+// boundedRange turns a [lower, upper) pair into the comparators matching the
+// requested operator: "=" (the default) keeps both bounds, "<"/"<=" keeps
+// only the exclusive upper bound, and ">"/">=" keeps only the inclusive
+// lower bound.
+func boundedRange(op comparatorOp, lower, upper SemVer) ([]comparator, error) {
+	switch op {
+	case opLt, opLte:
+		return []comparator{{op: opLt, ver: upper}}, nil
+	case opGt, opGte:
+		return []comparator{{op: opGte, ver: lower}}, nil
+	default:
+		return []comparator{
+			{op: opGte, ver: lower},
+			{op: opLt, ver: upper},
+		}, nil
+	}
+}
+
+type wildKind int
+
+const (
+	wildNone wildKind = iota
+	wildAll
+	wildMinor
+	wildPatch
+)
+
+// parsePartialVersion parses a possibly-partial, possibly-wildcard version
+// such as "1", "1.2", "1.x", "1.2.x", or "*", reporting which component (if
+// any) is missing or a wildcard.
+func parsePartialVersion(v string) (major, minor, patch int, wild wildKind, err error) {
+	if v == "" || v == "*" || v == "x" || v == "X" {
+		return 0, 0, 0, wildAll, nil
+	}
+
+	parts := strings.Split(v, ".")
+	if len(parts) > 3 {
+		return 0, 0, 0, wildNone, fmt.Errorf("invalid partial version %q", v)
+	}
+
+	major, err = strconv.Atoi(parts[0])
+	if err != nil {
+		return 0, 0, 0, wildNone, fmt.Errorf("invalid partial version %q", v)
+	}
+
+	if len(parts) < 2 || isWildcardToken(parts[1]) {
+		return major, 0, 0, wildMinor, nil
+	}
+	minor, err = strconv.Atoi(parts[1])
+	if err != nil {
+		return 0, 0, 0, wildNone, fmt.Errorf("invalid partial version %q", v)
+	}
+
+	if len(parts) < 3 || isWildcardToken(parts[2]) {
+		return major, minor, 0, wildPatch, nil
+	}
+	patch, err = strconv.Atoi(parts[2])
+	if err != nil {
+		return 0, 0, 0, wildNone, fmt.Errorf("invalid partial version %q", v)
+	}
+
+	return major, minor, patch, wildNone, nil
+}
+
+func isWildcardToken(s string) bool {
+	return s == "x" || s == "X" || s == "*"
+}
+
+// tildeRangeComparators expands "~1.2.3" into ">=1.2.3 <1.3.0", "~1.2" into
+// ">=1.2.0 <1.3.0", and "~1" into ">=1.0.0 <2.0.0".
+func tildeRangeComparators(v string) ([]comparator, error) {
+	major, minor, patch, wild, err := parsePartialVersion(v)
+	if err != nil {
+		return nil, fmt.Errorf("invalid tilde range %q: %w", v, err)
+	}
+
+	lower := SemVer{Major: major, Minor: minor, Patch: patch}
+	var upper SemVer
+	switch wild {
+	case wildMinor, wildAll:
+		upper = SemVer{Major: major + 1}
+	default:
+		upper = SemVer{Major: major, Minor: minor + 1}
+	}
+
+	return []comparator{
+		{op: opGte, ver: lower},
+		{op: opLt, ver: upper},
+	}, nil
+}
+
+// caretRangeComparators expands "^1.2.3" into ">=1.2.3 <2.0.0", with the
+// usual zero-major/zero-minor edge cases: "^0.2.3" -> ">=0.2.3 <0.3.0" and
+// "^0.0.3" -> ">=0.0.3 <0.0.4".
+func caretRangeComparators(v string) ([]comparator, error) {
+	major, minor, patch, wild, err := parsePartialVersion(v)
+	if err != nil {
+		return nil, fmt.Errorf("invalid caret range %q: %w", v, err)
+	}
+
+	lower := SemVer{Major: major, Minor: minor, Patch: patch}
+
+	var upper SemVer
+	switch {
+	case wild == wildMinor || wild == wildAll:
+		upper = SemVer{Major: major + 1}
+	case wild == wildPatch:
+		if major == 0 {
+			upper = SemVer{Minor: minor + 1}
+		} else {
+			upper = SemVer{Major: major + 1}
+		}
+	case major > 0:
+		upper = SemVer{Major: major + 1}
+	case minor > 0:
+		upper = SemVer{Minor: minor + 1}
+	default:
+		upper = SemVer{Patch: patch + 1}
+	}
+
+	return []comparator{
+		{op: opGte, ver: lower},
+		{op: opLt, ver: upper},
+	}, nil
+}
+
+// hyphenRangeComparators expands "A - B" into ">=A <=B". When B is a
+// partial version (e.g. "1.2.3 - 2.3") the upper bound is treated as a
+// wildcard, so "1.2.3 - 2.3" becomes ">=1.2.3 <2.4.0".
+func hyphenRangeComparators(low, high string) ([]comparator, error) {
+	lowMajor, lowMinor, lowPatch, lowWild, err := parsePartialVersion(low)
+	if err != nil {
+		return nil, fmt.Errorf("invalid hyphen range lower bound %q: %w", low, err)
+	}
+	lower := SemVer{Major: lowMajor, Minor: lowMinor, Patch: lowPatch}
+	_ = lowWild // a wildcard lower bound just means "from the start of that line"
+
+	highMajor, highMinor, highPatch, highWild, err := parsePartialVersion(high)
+	if err != nil {
+		return nil, fmt.Errorf("invalid hyphen range upper bound %q: %w", high, err)
+	}
+
+	var upperComparator comparator
+	switch highWild {
+	case wildAll:
+		upperComparator = comparator{op: opGte, ver: SemVer{}}
+	case wildMinor:
+		upperComparator = comparator{op: opLt, ver: SemVer{Major: highMajor + 1}}
+	case wildPatch:
+		upperComparator = comparator{op: opLt, ver: SemVer{Major: highMajor, Minor: highMinor + 1}}
+	default:
+		upperComparator = comparator{op: opLte, ver: SemVer{Major: highMajor, Minor: highMinor, Patch: highPatch}}
+	}
+
+	if highWild == wildAll {
+		return []comparator{{op: opGte, ver: lower}}, nil
+	}
+
+	return []comparator{
+		{op: opGte, ver: lower},
+		upperComparator,
+	}, nil
+}
+
+// MaxSatisfying returns the highest version in versions that satisfies r.
+func MaxSatisfying(versions []SemVer, r Range) (SemVer, bool) {
+	var best SemVer
+	found := false
+	for _, v := range versions {
+		if !r.Contains(v) {
+			continue
+		}
+		if !found || v.IsGrater(best) {
+			best = v
+			found = true
+		}
+	}
+	return best, found
+}
+
+// MinSatisfying returns the lowest version in versions that satisfies r.
+func MinSatisfying(versions []SemVer, r Range) (SemVer, bool) {
+	var best SemVer
+	found := false
+	for _, v := range versions {
+		if !r.Contains(v) {
+			continue
+		}
+		if !found || v.IsLess(best) {
+			best = v
+			found = true
+		}
+	}
+	return best, found
+}
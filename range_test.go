@@ -0,0 +1,145 @@
+package semver_test
+
+import (
+	"testing"
+
+	"github.com/Nidal-Bakir/go-semver"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestParseRangeAndContains(t *testing.T) {
+	a := assert.New(t)
+
+	type testCase struct {
+		rangeExpr string
+		matches   []string
+		noMatches []string
+	}
+
+	var testData = []testCase{
+		testCase{
+			rangeExpr: ">=1.2.3 <2.0.0",
+			matches:   []string{"1.2.3", "1.9.9", "1.2.3+build.1"},
+			noMatches: []string{"1.2.2", "2.0.0", "2.0.1"},
+		},
+		testCase{
+			rangeExpr: ">=1.2.3,<2.0.0",
+			matches:   []string{"1.2.3"},
+			noMatches: []string{"2.0.0"},
+		},
+		testCase{
+			rangeExpr: "1.x || 2.x",
+			matches:   []string{"1.0.0", "1.9.9", "2.0.0", "2.5.1"},
+			noMatches: []string{"0.9.9", "3.0.0"},
+		},
+		testCase{
+			rangeExpr: "1.2.3 - 2.3.4",
+			matches:   []string{"1.2.3", "2.3.4", "1.5.0"},
+			noMatches: []string{"1.2.2", "2.3.5"},
+		},
+		testCase{
+			rangeExpr: "1.2.x",
+			matches:   []string{"1.2.0", "1.2.9"},
+			noMatches: []string{"1.3.0", "1.1.9"},
+		},
+		testCase{
+			rangeExpr: "1.x",
+			matches:   []string{"1.0.0", "1.99.0"},
+			noMatches: []string{"2.0.0"},
+		},
+		testCase{
+			rangeExpr: "*",
+			matches:   []string{"0.0.0", "9.9.9"},
+			noMatches: []string{},
+		},
+		testCase{
+			rangeExpr: "~1.2.3",
+			matches:   []string{"1.2.3", "1.2.9"},
+			noMatches: []string{"1.2.2", "1.3.0"},
+		},
+		testCase{
+			rangeExpr: "^1.2.3",
+			matches:   []string{"1.2.3", "1.9.9"},
+			noMatches: []string{"1.2.2", "2.0.0"},
+		},
+		testCase{
+			rangeExpr: "^0.2.3",
+			matches:   []string{"0.2.3", "0.2.9"},
+			noMatches: []string{"0.2.2", "0.3.0"},
+		},
+		testCase{
+			rangeExpr: "^0.0.3",
+			matches:   []string{"0.0.3"},
+			noMatches: []string{"0.0.2", "0.0.4"},
+		},
+	}
+
+	for _, tc := range testData {
+		r, err := semver.ParseRange(tc.rangeExpr)
+		a.NoError(err, tc.rangeExpr)
+
+		for _, m := range tc.matches {
+			v := semver.MustParse(m)
+			a.True(r.Contains(v), "%q should contain %q", tc.rangeExpr, m)
+		}
+		for _, m := range tc.noMatches {
+			v := semver.MustParse(m)
+			a.False(r.Contains(v), "%q should not contain %q", tc.rangeExpr, m)
+		}
+	}
+}
+
+func TestParseRangeInvalid(t *testing.T) {
+	a := assert.New(t)
+
+	testData := []string{
+		"",
+		"1.2.3 ||",
+		">=",
+		"not-a-version",
+	}
+
+	for _, expr := range testData {
+		_, err := semver.ParseRange(expr)
+		a.Error(err, expr)
+	}
+}
+
+func TestRangePreReleaseOnlyMatchesExplicitLine(t *testing.T) {
+	a := assert.New(t)
+
+	r, err := semver.ParseRange(">=1.2.3-alpha <1.2.3")
+	a.NoError(err)
+
+	a.True(r.Contains(semver.MustParse("1.2.3-beta")))
+	a.False(r.Contains(semver.MustParse("1.2.4-alpha")))
+
+	r2, err := semver.ParseRange(">=1.0.0 <2.0.0")
+	a.NoError(err)
+	a.False(r2.Contains(semver.MustParse("1.5.0-alpha")))
+}
+
+func TestMaxAndMinSatisfying(t *testing.T) {
+	a := assert.New(t)
+
+	versions := []semver.SemVer{
+		semver.MustParse("1.0.0"),
+		semver.MustParse("1.2.3"),
+		semver.MustParse("1.5.0"),
+		semver.MustParse("2.0.0"),
+	}
+
+	r, err := semver.ParseRange("^1.0.0")
+	a.NoError(err)
+
+	maxV, ok := semver.MaxSatisfying(versions, r)
+	a.True(ok)
+	a.Equal("1.5.0", maxV.String())
+
+	minV, ok := semver.MinSatisfying(versions, r)
+	a.True(ok)
+	a.Equal("1.0.0", minV.String())
+
+	_, ok = semver.MaxSatisfying(versions, semver.MustParseRange(">=5.0.0"))
+	a.False(ok)
+}
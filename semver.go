@@ -8,7 +8,6 @@ import (
 	"errors"
 	"fmt"
 	"sort"
-	"strconv"
 	"strings"
 )
 
@@ -46,56 +45,19 @@ func (s SemVer) String() string {
 }
 
 func (s SemVer) IsGraterOrEquql(o SemVer) bool {
-	cmpResults := s.generateCompareToOtherResult(o)
-	for _, result := range cmpResults {
-		if result == 0 {
-			continue
-		}
-		return result > 0
-	}
-	return true
+	return s.Compare(o) >= 0
 }
 
 func (s SemVer) IsGrater(o SemVer) bool {
-	cmpResults := s.generateCompareToOtherResult(o)
-	for _, result := range cmpResults {
-		if result == 0 {
-			continue
-		}
-		return result > 0
-	}
-	return false
+	return s.Compare(o) > 0
 }
 
 func (s SemVer) IsLess(o SemVer) bool {
-	cmpResults := s.generateCompareToOtherResult(o)
-	for _, result := range cmpResults {
-		if result == 0 {
-			continue
-		}
-		return result < 0
-	}
-	return false
+	return s.Compare(o) < 0
 }
 
 func (s SemVer) IsLessOrEquql(o SemVer) bool {
-	cmpResults := s.generateCompareToOtherResult(o)
-	for _, result := range cmpResults {
-		if result == 0 {
-			continue
-		}
-		return result < 0
-	}
-	return true
-}
-
-func (s SemVer) generateCompareToOtherResult(o SemVer) []int {
-	res := make([]int, 4)
-	res[0] = cmp.Compare(s.Major, o.Major)
-	res[1] = cmp.Compare(s.Minor, o.Minor)
-	res[2] = cmp.Compare(s.Patch, o.Patch)
-	res[3] = s.comparePreRelease(o)
-	return res
+	return s.Compare(o) <= 0
 }
 
 func (s SemVer) IsEquql(o SemVer) bool {
@@ -107,15 +69,20 @@ func (s SemVer) IsEquql(o SemVer) bool {
 //	-1 if this is less than other,
 //	 0 if this equals other,
 //	+1 if this is greater than other.
+//
+// Compare short-circuits on the first component that differs, so it does
+// not allocate.
 func (s SemVer) Compare(other SemVer) int {
-	cmpResults := s.generateCompareToOtherResult(other)
-	for _, result := range cmpResults {
-		if result == 0 {
-			continue
-		}
-		return result
+	if c := cmp.Compare(s.Major, other.Major); c != 0 {
+		return c
+	}
+	if c := cmp.Compare(s.Minor, other.Minor); c != 0 {
+		return c
 	}
-	return 0
+	if c := cmp.Compare(s.Patch, other.Patch); c != 0 {
+		return c
+	}
+	return s.comparePreRelease(other)
 }
 
 // When major, minor, and patch are equal, a pre-release version has lower precedence than a normal version:
@@ -148,46 +115,68 @@ func (s SemVer) comparePreRelease(o SemVer) int {
 		return -1
 	}
 
-	thisPreReleaseSplit := strings.Split(s.PreRelease, ".")
-	otherPreReleaseSplit := strings.Split(o.PreRelease, ".")
-	for i := range min(len(thisPreReleaseSplit), len(otherPreReleaseSplit)) {
-		thisPart := thisPreReleaseSplit[i]
-		otherPart := otherPreReleaseSplit[i]
+	thisRemaining := s.PreRelease
+	otherRemaining := o.PreRelease
+	for {
+		thisPart, thisRest, thisHasMore := strings.Cut(thisRemaining, ".")
+		otherPart, otherRest, otherHasMore := strings.Cut(otherRemaining, ".")
 
-		tDigit, tOk := mayParseDigit(thisPart)
-		oDigit, oOk := mayParseDigit(otherPart)
+		tOk := isNumericIdentifier(thisPart)
+		oOk := isNumericIdentifier(otherPart)
 
-		if tOk || oOk { // one part is a digit
-			if tOk && oOk { // the two parts are digits
-				if tDigit == oDigit {
-					continue
-				}
-				return cmp.Compare(tDigit, oDigit)
+		switch {
+		case tOk && oOk: // both parts are digits: compare numerically
+			if c := compareNumericIdentifiers(thisPart, otherPart); c != 0 {
+				return c
+			}
+		case tOk: // only this part is a digit: it has lower precedence
+			return -1
+		case oOk: // only the other part is a digit: it has lower precedence
+			return 1
+		default: // the two parts are strings: compare lexically
+			if c := cmp.Compare(thisPart, otherPart); c != 0 {
+				return c
 			}
+		}
 
-			// one digit and the other is not
-			if tOk {
+		if !thisHasMore || !otherHasMore {
+			// The smallest identifier list completed at this point; a
+			// larger set of pre-release fields has higher precedence.
+			switch {
+			case thisHasMore == otherHasMore:
+				return 0
+			case thisHasMore:
+				return 1
+			default:
 				return -1
 			}
-			return 1
 		}
+		thisRemaining, otherRemaining = thisRest, otherRest
+	}
+}
 
-		// the two parts are strings
-		cmpResult := cmp.Compare(thisPart, otherPart)
-		if cmpResult == 0 {
-			continue
+func isNumericIdentifier(s string) bool {
+	if s == "" {
+		return false
+	}
+	for i := 0; i < len(s); i++ {
+		if s[i] < '0' || s[i] > '9' {
+			return false
 		}
-		return cmpResult
 	}
-
-	// The smallest split completed at this point is equal to the other split.
-	// Return the comparison of the lengths of the two PreRelease versions.
-	return cmp.Compare(thisLen, otherLen)
+	return true
 }
 
-func mayParseDigit(s string) (int, bool) {
-	v, err := strconv.Atoi(s)
-	return v, err == nil
+// compareNumericIdentifiers compares two all-digit identifiers numerically
+// without converting them to int, so identifiers longer than a machine
+// word don't overflow.
+func compareNumericIdentifiers(a, b string) int {
+	a = strings.TrimLeft(a, "0")
+	b = strings.TrimLeft(b, "0")
+	if len(a) != len(b) {
+		return cmp.Compare(len(a), len(b))
+	}
+	return cmp.Compare(a, b)
 }
 
 func MustParse(semverStr string) SemVer {
@@ -198,60 +187,18 @@ func MustParse(semverStr string) SemVer {
 	return v
 }
 
+// Parse parses a SemVer from its string form.
+//
+// Parse is lenient: it tolerates things the SemVer 2.0.0 spec does not, such
+// as empty pre-release/build identifiers or numeric pre-release identifiers
+// with leading zeros. Use [ParseStrict] to reject those and enforce the full
+// grammar at https://semver.org.
 func Parse(semverStr string) (SemVer, error) {
-	parts := make([]strings.Builder, 5)
-	partIndex := 0
-	didEnterPreReleasePart := false
-	didEnterBuildMetadataPart := false
-	for _, c := range semverStr {
-		if c == '.' && partIndex < 2 {
-			partIndex++
-			continue
-		}
-
-		if c == '-' && !didEnterPreReleasePart && !didEnterBuildMetadataPart {
-			didEnterPreReleasePart = true
-			partIndex = 3
-			continue
-		}
-
-		if c == '+' && !didEnterBuildMetadataPart {
-			didEnterBuildMetadataPart = true
-			partIndex = 4
-			continue
-		}
-
-		parts[partIndex].WriteRune(c)
-	}
-
-	var semver SemVer
-
-	major, err := strconv.Atoi(parts[0].String())
-	if err != nil {
-		return semver, ErrInvalidSemVerSyntax
-	}
-	semver.Major = major
-
-	minor, err := strconv.Atoi(parts[1].String())
-	if err != nil {
-		return semver, ErrInvalidSemVerSyntax
-	}
-	semver.Minor = minor
-
-	patch, err := strconv.Atoi(parts[2].String())
-	if err != nil {
-		return semver, ErrInvalidSemVerSyntax
-	}
-	semver.Patch = patch
-
-	semver.PreRelease = parts[3].String()
-	semver.BuildMetadata = parts[4].String()
-
-	return semver, nil
+	return ParseWithOptions(semverStr, ParseOptions{})
 }
 
 func IsValid(v string) bool {
-	_, err := Parse(v)
+	_, err := ParseStrict(v)
 	return err == nil
 }
 
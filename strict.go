@@ -0,0 +1,169 @@
+package semver
+
+import (
+	"strconv"
+	"strings"
+)
+
+// ParseOptions controls how [ParseWithOptions] validates its input.
+type ParseOptions struct {
+	// Strict enforces the full SemVer 2.0.0 grammar: MAJOR/MINOR/PATCH must
+	// be non-empty digit runs with no leading zeros (unless the value is
+	// "0"), every dot-separated pre-release identifier must be non-empty
+	// and match [0-9A-Za-z-] (no leading zero if purely numeric), and every
+	// build identifier must be non-empty and match [0-9A-Za-z-].
+	//
+	// When false, the relaxed behavior of [Parse] is used instead.
+	Strict bool
+}
+
+// ParseStrict parses a SemVer, rejecting anything that does not conform to
+// the full grammar described at https://semver.org. It is equivalent to
+// ParseWithOptions(semverStr, ParseOptions{Strict: true}).
+func ParseStrict(semverStr string) (SemVer, error) {
+	return ParseWithOptions(semverStr, ParseOptions{Strict: true})
+}
+
+// ParseWithOptions parses a SemVer according to opts. See [ParseOptions] for
+// the behavior strict mode enables.
+//
+// ParseWithOptions is a single-pass, index-based parser: it never allocates
+// itself, since PreRelease and BuildMetadata are stored as substrings of
+// semverStr. The only allocation in the call chain is the returned SemVer
+// value.
+func ParseWithOptions(semverStr string, opts ParseOptions) (SemVer, error) {
+	var semver SemVer
+
+	// segStart/segEnd mark the byte range of each of the five grammar
+	// components (major, minor, patch, pre-release, build metadata) within
+	// semverStr. A component that never appears (e.g. no pre-release) keeps
+	// its zero value, yielding an empty substring.
+	var segStart, segEnd [5]int
+	partIndex := 0
+	didEnterPreReleasePart := false
+	didEnterBuildMetadataPart := false
+
+	for i := 0; i < len(semverStr); i++ {
+		c := semverStr[i]
+
+		if c == '.' && partIndex < 2 {
+			segEnd[partIndex] = i
+			partIndex++
+			segStart[partIndex] = i + 1
+			continue
+		}
+
+		if c == '-' && !didEnterPreReleasePart && !didEnterBuildMetadataPart {
+			segEnd[partIndex] = i
+			didEnterPreReleasePart = true
+			partIndex = 3
+			segStart[3] = i + 1
+			continue
+		}
+
+		if c == '+' && !didEnterBuildMetadataPart {
+			segEnd[partIndex] = i
+			didEnterBuildMetadataPart = true
+			partIndex = 4
+			segStart[4] = i + 1
+			continue
+		}
+	}
+	segEnd[partIndex] = len(semverStr)
+
+	majorStr := semverStr[segStart[0]:segEnd[0]]
+	minorStr := semverStr[segStart[1]:segEnd[1]]
+	patchStr := semverStr[segStart[2]:segEnd[2]]
+	preRelease := semverStr[segStart[3]:segEnd[3]]
+	buildMetadata := semverStr[segStart[4]:segEnd[4]]
+
+	if opts.Strict {
+		if !isStrictNumericIdentifier(majorStr) || !isStrictNumericIdentifier(minorStr) || !isStrictNumericIdentifier(patchStr) {
+			return semver, ErrInvalidSemVerSyntax
+		}
+		if didEnterPreReleasePart && !isStrictDotSeparatedIdentifiers(preRelease, true) {
+			return semver, ErrInvalidSemVerSyntax
+		}
+		if didEnterBuildMetadataPart && !isStrictDotSeparatedIdentifiers(buildMetadata, false) {
+			return semver, ErrInvalidSemVerSyntax
+		}
+	}
+
+	major, err := strconv.Atoi(majorStr)
+	if err != nil {
+		return semver, ErrInvalidSemVerSyntax
+	}
+	semver.Major = major
+
+	minor, err := strconv.Atoi(minorStr)
+	if err != nil {
+		return semver, ErrInvalidSemVerSyntax
+	}
+	semver.Minor = minor
+
+	patch, err := strconv.Atoi(patchStr)
+	if err != nil {
+		return semver, ErrInvalidSemVerSyntax
+	}
+	semver.Patch = patch
+
+	semver.PreRelease = preRelease
+	semver.BuildMetadata = buildMetadata
+
+	return semver, nil
+}
+
+// isStrictNumericIdentifier reports whether s is a non-empty run of decimal
+// digits with no leading zero, unless s is exactly "0".
+func isStrictNumericIdentifier(s string) bool {
+	if s == "" {
+		return false
+	}
+	for i := 0; i < len(s); i++ {
+		if s[i] < '0' || s[i] > '9' {
+			return false
+		}
+	}
+	return s == "0" || s[0] != '0'
+}
+
+// isStrictDotSeparatedIdentifiers reports whether s is a dot-separated list
+// of identifiers, each non-empty and matching [0-9A-Za-z-]. When
+// numericLeadingZeroRejected is true (pre-release identifiers), a purely
+// numeric identifier with a leading zero is rejected; build identifiers
+// allow leading zeros.
+func isStrictDotSeparatedIdentifiers(s string, numericLeadingZeroRejected bool) bool {
+	for {
+		ident, rest, hasMore := strings.Cut(s, ".")
+		if !isStrictIdentifier(ident, numericLeadingZeroRejected) {
+			return false
+		}
+		if !hasMore {
+			return true
+		}
+		s = rest
+	}
+}
+
+func isStrictIdentifier(ident string, numericLeadingZeroRejected bool) bool {
+	if ident == "" {
+		return false
+	}
+
+	allDigits := true
+	for i := 0; i < len(ident); i++ {
+		c := ident[i]
+		switch {
+		case c >= '0' && c <= '9':
+		case c >= 'a' && c <= 'z', c >= 'A' && c <= 'Z', c == '-':
+			allDigits = false
+		default:
+			return false
+		}
+	}
+
+	if numericLeadingZeroRejected && allDigits && len(ident) > 1 && ident[0] == '0' {
+		return false
+	}
+	return true
+}
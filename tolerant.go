@@ -0,0 +1,52 @@
+package semver
+
+import "strings"
+
+// ParseTolerant parses s like [Parse], but additionally tolerates an
+// optional leading "v"/"V" and shorthand forms that omit MINOR and/or PATCH
+// (e.g. "v1", "v1.2"), filling the missing components with 0. This matches
+// the conventions used by git tags and the Go module proxy, as popularized
+// by golang.org/x/mod/semver.
+//
+// Parse itself stays strict about the no-prefix form, so existing callers
+// of Parse are unaffected.
+func ParseTolerant(s string) (SemVer, error) {
+	s = strings.TrimPrefix(strings.TrimPrefix(s, "v"), "V")
+
+	numeric, suffix := splitNumericPrefix(s)
+	switch strings.Count(numeric, ".") {
+	case 0:
+		numeric += ".0.0"
+	case 1:
+		numeric += ".0"
+	}
+
+	return Parse(numeric + suffix)
+}
+
+// splitNumericPrefix splits s into its leading MAJOR[.MINOR[.PATCH]] run and
+// the remaining pre-release/build suffix (starting at the first "-" or "+").
+func splitNumericPrefix(s string) (numeric, suffix string) {
+	for i := 0; i < len(s); i++ {
+		if s[i] == '-' || s[i] == '+' {
+			return s[:i], s[i:]
+		}
+	}
+	return s, ""
+}
+
+// Canonical returns the canonical MAJOR.MINOR.PATCH[-pre][+build] form of a
+// tolerant version string s, as accepted by [ParseTolerant].
+func Canonical(s string) (string, error) {
+	v, err := ParseTolerant(s)
+	if err != nil {
+		return "", err
+	}
+	return v.String(), nil
+}
+
+// StringWithPrefix returns s's string form with prefix prepended, e.g.
+// v.StringWithPrefix("v") yields "v1.2.3".
+func (s SemVer) StringWithPrefix(prefix string) string {
+	return prefix + s.String()
+}
@@ -0,0 +1,56 @@
+package semver_test
+
+import (
+	"testing"
+
+	"github.com/Nidal-Bakir/go-semver"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestParseTolerant(t *testing.T) {
+	a := assert.New(t)
+
+	type testCase struct {
+		input    string
+		expected string
+	}
+
+	var testData = []testCase{
+		testCase{input: "v1", expected: "1.0.0"},
+		testCase{input: "v1.2", expected: "1.2.0"},
+		testCase{input: "V1.2.3-rc.1", expected: "1.2.3-rc.1"},
+		testCase{input: "1", expected: "1.0.0"},
+		testCase{input: "1.2", expected: "1.2.0"},
+		testCase{input: "v1.2.3", expected: "1.2.3"},
+		testCase{input: "v1.2.3+build", expected: "1.2.3+build"},
+		testCase{input: "v1-alpha", expected: "1.0.0-alpha"},
+	}
+
+	for _, tc := range testData {
+		v, err := semver.ParseTolerant(tc.input)
+		a.NoError(err, tc.input)
+		a.Equal(tc.expected, v.String(), tc.input)
+	}
+}
+
+func TestParseStaysStrictAboutPrefix(t *testing.T) {
+	a := assert.New(t)
+
+	_, err := semver.Parse("v1.2.3")
+	a.Error(err)
+}
+
+func TestCanonical(t *testing.T) {
+	a := assert.New(t)
+
+	got, err := semver.Canonical("v1.2")
+	a.NoError(err)
+	a.Equal("1.2.0", got)
+}
+
+func TestStringWithPrefix(t *testing.T) {
+	a := assert.New(t)
+
+	v := semver.MustParse("1.2.3-rc.1")
+	a.Equal("v1.2.3-rc.1", v.StringWithPrefix("v"))
+}